@@ -0,0 +1,143 @@
+package poster
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	nodeblob "github.com/celestiaorg/celestia-node/blob"
+	libshare "github.com/celestiaorg/go-square/v3/share"
+)
+
+// maxSharesPerPFB bounds how many shares worth of blobs submit_batch packs
+// into a single PayForBlob tx, conservatively matching a 64x64 original
+// data square so packed batches stay well under the network's max square
+// size regardless of governance params.
+const maxSharesPerPFB = 64 * 64
+
+// SubmitBatch posts every blob in req.Blobs, packing them into
+// share-count-bounded groups and submitting each group as a single PFB,
+// falling back to additional PFBs only when the combined share count of a
+// batch would exceed maxSharesPerPFB. This is the batched counterpart to
+// submit, which keeps the single-blob NamespaceIDB64/DataB64 fields for
+// back-compat.
+func (s *Service) SubmitBatch(ctx context.Context, req Request) (Response, error) {
+	if len(req.Blobs) == 0 {
+		return Response{}, fmt.Errorf("blobs is required for submit_batch")
+	}
+
+	keyName := s.resolveKeyName(req)
+	address, err := s.addressForKey(keyName)
+	if err != nil {
+		return Response{}, err
+	}
+
+	groups, err := packBlobs(req.Blobs)
+	if err != nil {
+		return Response{}, err
+	}
+
+	resp := Response{OK: true, Mode: "submit_batch", PosterAddress: address, KeyName: keyName}
+
+	gasPrice := s.gasOracle.Price()
+	if req.GasPrice != nil {
+		gasPrice = *req.GasPrice
+	}
+
+	opts := SubmitOptions{KeyName: keyName, SignerAddress: req.SignerAddress}
+
+	for gi, group := range groups {
+		result, err := s.da.Submit(ctx, group.blobs, gasPrice, opts)
+		if err != nil {
+			// Groups before this one may already be paid for and posted
+			// on-chain; return what's known instead of discarding it, so a
+			// caller that retries on error doesn't resubmit (and
+			// double-pay for) blobs that already landed.
+			resp.OK = false
+			resp.Error = fmt.Sprintf("submit pay-for-blob tx %d of %d: %v", gi+1, len(groups), err)
+			break
+		}
+
+		resp.TxHashes = append(resp.TxHashes, result.TxHash)
+		resp.BlobsPosted += len(group.blobs)
+		resp.SharesUsed += group.shares
+		for _, commitment := range group.commitments {
+			resp.BlobCommitments = append(resp.BlobCommitments, base64.StdEncoding.EncodeToString(commitment))
+		}
+
+		if result.Code != 0 {
+			resp.OK = false
+			resp.Error = fmt.Sprintf("celestia tx failed with code %d", result.Code)
+			continue
+		}
+
+		if result.Height > 0 {
+			s.keyPool.MarkUsed(keyName, uint64(result.Height))
+			for i, commitment := range group.commitments {
+				s.commitments.record(result.TxHash, result.Height, group.namespaces[i], commitment)
+			}
+		}
+	}
+
+	if bal, err := s.da.BalanceForAddress(ctx, address); err == nil {
+		resp.Balance = bal
+	}
+
+	return resp, nil
+}
+
+// blobGroup is one share-count-bounded batch destined for a single PFB.
+// blobs, namespaces, and commitments are parallel slices, one entry per
+// blob in req.Blobs order, so a group's commitments can be attributed back
+// to the request that produced them instead of re-derived from the chain.
+type blobGroup struct {
+	blobs       []*libshare.Blob
+	namespaces  []libshare.Namespace
+	commitments [][]byte
+	shares      int
+}
+
+// packBlobs decodes each requested blob, computes its commitment locally
+// from its bytes, and greedily packs them into groups whose combined share
+// count stays within maxSharesPerPFB. Share counts come from
+// libshare.SparseSharesNeeded, the same share-layout calculation
+// celestia-app uses, rather than fee.go's byte-size approximation: getting
+// this wrong would silently overpack a PFB past the square limit.
+func packBlobs(inputs []BlobInput) ([]blobGroup, error) {
+	var groups []blobGroup
+	var current blobGroup
+
+	for i, in := range inputs {
+		namespace, err := ParseNamespace(in.NamespaceIDB64)
+		if err != nil {
+			return nil, fmt.Errorf("parse namespace_id_b64 for blob %d: %w", i, err)
+		}
+		data, err := base64.StdEncoding.DecodeString(in.DataB64)
+		if err != nil {
+			return nil, fmt.Errorf("decode data_b64 for blob %d: %w", i, err)
+		}
+		nb, err := nodeblob.NewBlobV0(namespace, data)
+		if err != nil {
+			return nil, fmt.Errorf("build blob %d: %w", i, err)
+		}
+
+		shares := libshare.SparseSharesNeeded(uint32(len(data)))
+		if shares > maxSharesPerPFB {
+			return nil, fmt.Errorf("blob %d needs %d shares, which exceeds the maxSharesPerPFB limit of %d on its own", i, shares, maxSharesPerPFB)
+		}
+		if current.shares > 0 && current.shares+shares > maxSharesPerPFB {
+			groups = append(groups, current)
+			current = blobGroup{}
+		}
+
+		current.blobs = append(current.blobs, nb.Blob)
+		current.namespaces = append(current.namespaces, namespace)
+		current.commitments = append(current.commitments, nb.Commitment)
+		current.shares += shares
+	}
+	if len(current.blobs) > 0 {
+		groups = append(groups, current)
+	}
+
+	return groups, nil
+}
@@ -0,0 +1,88 @@
+package poster
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	libshare "github.com/celestiaorg/go-square/v3/share"
+)
+
+func blobInput(t *testing.T, sizeBytes int) BlobInput {
+	t.Helper()
+	return BlobInput{
+		NamespaceIDB64: namespaceB64("batch"),
+		DataB64:        base64.StdEncoding.EncodeToString([]byte(strings.Repeat("a", sizeBytes))),
+	}
+}
+
+// bytesForShares returns a byte length that actually needs shares per
+// libshare.SparseSharesNeeded -- the same calculation packBlobs uses -- so
+// these tests exercise real share-layout boundaries instead of a
+// hardcoded bytes-per-share approximation that could drift from it.
+func bytesForShares(t *testing.T, shares int) int {
+	t.Helper()
+	for n := 1; ; n++ {
+		got := libshare.SparseSharesNeeded(uint32(n))
+		if got == shares {
+			return n
+		}
+		if got > shares {
+			t.Fatalf("no byte length needs exactly %d shares", shares)
+		}
+	}
+}
+
+func TestPackBlobsSingleGroup(t *testing.T) {
+	inputs := []BlobInput{blobInput(t, 100), blobInput(t, 200)}
+	groups, err := packBlobs(inputs)
+	if err != nil {
+		t.Fatalf("packBlobs: %v", err)
+	}
+	if len(groups) != 1 {
+		t.Fatalf("expected 1 group, got %d", len(groups))
+	}
+	if len(groups[0].blobs) != 2 {
+		t.Fatalf("expected both blobs in the same group, got %d", len(groups[0].blobs))
+	}
+}
+
+func TestPackBlobsSplitsOnceShareLimitIsExceeded(t *testing.T) {
+	// Each blob is sized to just over half of maxSharesPerPFB, so no two of
+	// them fit in one group and each blob forces a new one.
+	perBlobShares := maxSharesPerPFB/2 + 1
+	sizeBytes := bytesForShares(t, perBlobShares)
+	inputs := []BlobInput{blobInput(t, sizeBytes), blobInput(t, sizeBytes), blobInput(t, sizeBytes)}
+
+	groups, err := packBlobs(inputs)
+	if err != nil {
+		t.Fatalf("packBlobs: %v", err)
+	}
+	if len(groups) != 3 {
+		t.Fatalf("expected each oversized-together blob in its own group, got %d groups", len(groups))
+	}
+	for i, g := range groups {
+		if len(g.blobs) != 1 {
+			t.Fatalf("group %d: expected 1 blob, got %d", i, len(g.blobs))
+		}
+	}
+}
+
+func TestPackBlobsRejectsIndividuallyOversizedBlob(t *testing.T) {
+	sizeBytes := bytesForShares(t, maxSharesPerPFB+1)
+	_, err := packBlobs([]BlobInput{blobInput(t, sizeBytes)})
+	if err == nil {
+		t.Fatal("expected an error for a blob that exceeds maxSharesPerPFB on its own")
+	}
+}
+
+func TestPackBlobsOversizedBlobErrorsEvenAsFirstInGroup(t *testing.T) {
+	// Regression test: current.shares is 0 for the first blob in a group,
+	// so the combined-size check alone would never catch an oversized
+	// first blob.
+	sizeBytes := bytesForShares(t, maxSharesPerPFB+1)
+	_, err := packBlobs([]BlobInput{blobInput(t, sizeBytes), blobInput(t, 100)})
+	if err == nil {
+		t.Fatal("expected an error for the oversized first blob")
+	}
+}
@@ -0,0 +1,94 @@
+package poster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-node/api/client"
+	nodeblob "github.com/celestiaorg/celestia-node/blob"
+	"github.com/celestiaorg/celestia-node/state"
+	libshare "github.com/celestiaorg/go-square/v3/share"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// celestiaSubmitter backs DASubmitter with the real celestia-node client.
+// It is the default backend, and the only one Service falls back to for
+// the celestia-specific extras (header row roots, per-key balances,
+// inclusion verification) that don't generalize to other DA layers.
+type celestiaSubmitter struct {
+	cl *client.Client
+}
+
+func newCelestiaSubmitter(ctx context.Context, cfg Config, kr keyring.Keyring) (*celestiaSubmitter, error) {
+	cl, err := client.New(ctx, client.Config{
+		ReadConfig: client.ReadConfig{
+			BridgeDAAddr: cfg.DAURL,
+			DAAuthToken:  cfg.DAAuthToken,
+			EnableDATLS:  cfg.EnableDATLS,
+		},
+		SubmitConfig: client.SubmitConfig{
+			DefaultKeyName: cfg.DefaultKeyName,
+			Network:        cfg.Network,
+			CoreGRPCConfig: client.CoreGRPCConfig{
+				Addr:       cfg.CoreGRPCAddr,
+				TLSEnabled: cfg.EnableCoreTLS,
+				AuthToken:  cfg.CoreAuthToken,
+			},
+		},
+	}, kr)
+	if err != nil {
+		return nil, fmt.Errorf("init celestia client: %w", err)
+	}
+	return &celestiaSubmitter{cl: cl}, nil
+}
+
+func (c *celestiaSubmitter) Balance(ctx context.Context) (*CoinBalance, error) {
+	bal, err := c.cl.State.Balance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CoinBalance{Denom: bal.Denom, Amount: bal.Amount.String()}, nil
+}
+
+func (c *celestiaSubmitter) BalanceForAddress(ctx context.Context, address string) (*CoinBalance, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, fmt.Errorf("parse address %q: %w", address, err)
+	}
+	bal, err := c.cl.State.BalanceForAddress(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &CoinBalance{Denom: bal.Denom, Amount: bal.Amount.String()}, nil
+}
+
+func (c *celestiaSubmitter) Submit(ctx context.Context, blobs []*libshare.Blob, gasPrice float64, opts SubmitOptions) (*SubmitResult, error) {
+	configOpts := []state.ConfigOption{state.WithGasPrice(gasPrice)}
+	if opts.KeyName != "" {
+		configOpts = append(configOpts, state.WithKeyName(opts.KeyName))
+	}
+	if opts.SignerAddress != "" {
+		configOpts = append(configOpts, state.WithSignerAddress(opts.SignerAddress))
+	}
+
+	txConfig := state.NewTxConfig(configOpts...)
+	txResp, err := c.cl.State.SubmitPayForBlob(ctx, blobs, txConfig)
+	if txResp == nil {
+		return nil, err
+	}
+	// SubmitPayForBlob can return a partial txResp alongside a non-nil err,
+	// e.g. when it times out waiting for inclusion after broadcasting: the
+	// tx hash is still known, so callers can check whether it landed before
+	// deciding to resubmit. Propagate err so a failed or timed-out call is
+	// never mistaken for a successful one.
+	return &SubmitResult{TxHash: txResp.TxHash, Height: txResp.Height, Code: txResp.Code, RawLog: txResp.RawLog}, err
+}
+
+func (c *celestiaSubmitter) GetProof(ctx context.Context, height uint64, namespace libshare.Namespace, commitment []byte) (*nodeblob.Proof, error) {
+	return c.cl.Blob.GetProof(ctx, height, namespace, commitment)
+}
+
+func (c *celestiaSubmitter) Close() error {
+	return c.cl.Close()
+}
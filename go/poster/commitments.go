@@ -0,0 +1,96 @@
+package poster
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	libshare "github.com/celestiaorg/go-square/v3/share"
+)
+
+// maxTrackedCommitmentTxs bounds how many distinct tx hashes commitmentIndex
+// retains, so a long-running daemon's memory doesn't grow without bound
+// over weeks of submit traffic. Once full, the oldest tracked tx is evicted
+// to make room, on the assumption that get_proof callers ask for a proof
+// soon after Submit/SubmitBatch return a tx hash rather than much later.
+const maxTrackedCommitmentTxs = 10000
+
+// commitmentRecord is one blob's commitment as computed locally from its
+// bytes at submit time, plus the height it landed at.
+type commitmentRecord struct {
+	height     int64
+	namespace  libshare.Namespace
+	commitment []byte
+}
+
+// commitmentIndex remembers, per tx hash, the commitment of every blob this
+// process submitted in it. get_proof checks it first so a recent tx_hash
+// resolves without an unordered namespace+height chain query, which could
+// return an arbitrary co-located blob whenever more than one blob lands in
+// the same namespace at the same height. It is bounded and FIFO-evicted
+// rather than a durable store, so resolving an older or cross-process
+// tx_hash that has aged out (or was never submitted by this process) fails
+// with an explicit error in resolveHeightAndCommitment asking the caller to
+// pass height+commitment_b64 instead, rather than falling back to an
+// ambiguous chain scan.
+type commitmentIndex struct {
+	mu      sync.Mutex
+	records map[string][]commitmentRecord
+	order   []string
+}
+
+func newCommitmentIndex() *commitmentIndex {
+	return &commitmentIndex{records: make(map[string][]commitmentRecord)}
+}
+
+// record stores the commitment of one blob posted in txHash at height.
+func (c *commitmentIndex) record(txHash string, height int64, namespace libshare.Namespace, commitment []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, tracked := c.records[txHash]; !tracked {
+		if len(c.order) >= maxTrackedCommitmentTxs {
+			oldest := c.order[0]
+			c.order = c.order[1:]
+			delete(c.records, oldest)
+		}
+		c.order = append(c.order, txHash)
+	}
+	c.records[txHash] = append(c.records[txHash], commitmentRecord{
+		height:     height,
+		namespace:  namespace,
+		commitment: commitment,
+	})
+}
+
+// lookup returns the height and commitment of the single blob txHash posted
+// in namespace. ok is false if txHash isn't tracked (this process never
+// submitted it, or it aged out of the bounded index), letting the caller
+// tell an untracked tx apart from a tracked one that cannot be resolved in
+// namespace. A tracked txHash that posted more
+// than one blob in namespace is still an error, since there is no way to
+// pick one without ambiguity.
+func (c *commitmentIndex) lookup(txHash string, namespace libshare.Namespace) (height int64, commitment []byte, ok bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	recs, tracked := c.records[txHash]
+	if !tracked {
+		return 0, nil, false, nil
+	}
+
+	var match *commitmentRecord
+	for i, rec := range recs {
+		if !bytes.Equal(rec.namespace.Bytes(), namespace.Bytes()) {
+			continue
+		}
+		if match != nil {
+			return 0, nil, true, fmt.Errorf("tx %q posted more than one blob in this namespace; pass height and commitment_b64 explicitly", txHash)
+		}
+		match = &recs[i]
+	}
+	if match == nil {
+		return 0, nil, true, fmt.Errorf("tx %q has no recorded blob in this namespace", txHash)
+	}
+	return match.height, match.commitment, true, nil
+}
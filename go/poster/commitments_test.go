@@ -0,0 +1,82 @@
+package poster
+
+import (
+	"fmt"
+	"testing"
+
+	libshare "github.com/celestiaorg/go-square/v3/share"
+)
+
+func TestCommitmentIndexLookupUnknownTx(t *testing.T) {
+	idx := newCommitmentIndex()
+	_, _, ok, err := idx.lookup("unknown-tx", testNamespace(t))
+	if ok {
+		t.Fatal("expected ok=false for a tx the index never recorded")
+	}
+	if err != nil {
+		t.Fatalf("expected no error for an untracked tx, got %v", err)
+	}
+}
+
+func TestCommitmentIndexLookupResolvesRecordedTx(t *testing.T) {
+	idx := newCommitmentIndex()
+	ns := testNamespace(t)
+	idx.record("tx-1", 42, ns, []byte("commitment-1"))
+
+	height, commitment, ok, err := idx.lookup("tx-1", ns)
+	if !ok || err != nil {
+		t.Fatalf("lookup: ok=%v err=%v", ok, err)
+	}
+	if height != 42 || string(commitment) != "commitment-1" {
+		t.Fatalf("got height=%d commitment=%q, want height=42 commitment=%q", height, commitment, "commitment-1")
+	}
+}
+
+func TestCommitmentIndexAmbiguousLookupErrors(t *testing.T) {
+	idx := newCommitmentIndex()
+	ns := testNamespace(t)
+	idx.record("tx-1", 42, ns, []byte("commitment-1"))
+	idx.record("tx-1", 42, ns, []byte("commitment-2"))
+
+	_, _, ok, err := idx.lookup("tx-1", ns)
+	if !ok {
+		t.Fatal("expected ok=true: the tx is tracked, even though it's ambiguous")
+	}
+	if err == nil {
+		t.Fatal("expected an error when a tracked tx posted more than one blob in the namespace")
+	}
+}
+
+func TestCommitmentIndexEvictsOldestTxOnceFull(t *testing.T) {
+	idx := newCommitmentIndex()
+	ns := testNamespace(t)
+
+	for i := 0; i < maxTrackedCommitmentTxs+1; i++ {
+		idx.record(fmt.Sprintf("tx-%d", i), int64(i), ns, []byte(fmt.Sprintf("commitment-%d", i)))
+	}
+
+	if _, _, ok, _ := idx.lookup("tx-0", ns); ok {
+		t.Fatal("expected the oldest tx to be evicted once the index is full")
+	}
+	if _, _, ok, err := idx.lookup(fmt.Sprintf("tx-%d", maxTrackedCommitmentTxs), ns); !ok || err != nil {
+		t.Fatalf("expected the newest tx to still be tracked, got ok=%v err=%v", ok, err)
+	}
+}
+
+func TestCommitmentIndexLookupIgnoresOtherNamespaces(t *testing.T) {
+	idx := newCommitmentIndex()
+	recordedNS := testNamespace(t)
+	otherNS, err := libshare.NewV0Namespace([]byte("other-ns"))
+	if err != nil {
+		t.Fatalf("build namespace: %v", err)
+	}
+	idx.record("tx-1", 42, recordedNS, []byte("commitment-1"))
+
+	_, _, ok, err := idx.lookup("tx-1", otherNS)
+	if !ok {
+		t.Fatal("expected ok=true: the tx is tracked, just not in this namespace")
+	}
+	if err == nil {
+		t.Fatal("expected an error when the tx has no recorded blob in the requested namespace")
+	}
+}
@@ -0,0 +1,151 @@
+package poster
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/celestiaorg/celestia-node/nodebuilder/p2p"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const defaultTimeout = 120 * time.Second
+
+// fallbackGasPrice is used when CELESTIA_GO_DEFAULT_GAS_PRICE is unset and
+// the gas-price oracle has not yet produced a sample. It matches
+// celestia-app's historical default min gas price of 0.002utia.
+const fallbackGasPrice = 0.002
+
+// Config holds the long-lived settings a Service is built from. Unlike
+// Request, it is read once from the environment at process start rather
+// than per-call, since it backs a persistent DA client connection.
+type Config struct {
+	DAURL           string
+	DAAuthToken     string
+	CoreGRPCAddr    string
+	CoreAuthToken   string
+	Network         p2p.Network
+	KeyringDir      string
+	KeyringBackend  string
+	DefaultKeyName  string
+	EnableDATLS     bool
+	EnableCoreTLS   bool
+	Timeout         time.Duration
+	DefaultGasPrice float64
+	DABackend       string
+}
+
+// LoadConfig reads the poster's runtime configuration from the environment.
+func LoadConfig() (Config, error) {
+	daURL := strings.TrimSpace(os.Getenv("CELESTIA_GO_DA_URL"))
+	if daURL == "" {
+		return Config{}, fmt.Errorf("CELESTIA_GO_DA_URL is required")
+	}
+	parsedDAURL, err := url.Parse(daURL)
+	if err != nil {
+		return Config{}, fmt.Errorf("parse CELESTIA_GO_DA_URL: %w", err)
+	}
+	if parsedDAURL.Scheme == "" || parsedDAURL.Host == "" {
+		return Config{}, fmt.Errorf("CELESTIA_GO_DA_URL must include scheme and host")
+	}
+
+	daToken := strings.TrimSpace(os.Getenv("CELESTIA_GO_DA_AUTH_TOKEN"))
+	if daToken == "" {
+		daToken = deriveTokenFromPath(parsedDAURL.Path)
+	}
+
+	coreGRPCAddr := strings.TrimSpace(os.Getenv("CELESTIA_GO_CORE_GRPC_ADDR"))
+	if coreGRPCAddr == "" {
+		host := parsedDAURL.Hostname()
+		if host == "" {
+			return Config{}, fmt.Errorf("unable to derive CELESTIA_GO_CORE_GRPC_ADDR from CELESTIA_GO_DA_URL")
+		}
+		coreGRPCAddr = host + ":9090"
+	}
+
+	coreToken := strings.TrimSpace(os.Getenv("CELESTIA_GO_CORE_AUTH_TOKEN"))
+	if coreToken == "" {
+		coreToken = daToken
+	}
+
+	network := strings.TrimSpace(os.Getenv("CELESTIA_GO_NETWORK"))
+	if network == "" {
+		network = "mocha-4"
+	}
+
+	keyringDir := strings.TrimSpace(os.Getenv("CELESTIA_GO_KEYRING_DIR"))
+	if keyringDir == "" {
+		keyringDir = ".celestia-poster-keys"
+	}
+	if !filepath.IsAbs(keyringDir) {
+		wd, err := os.Getwd()
+		if err != nil {
+			return Config{}, fmt.Errorf("read working directory: %w", err)
+		}
+		keyringDir = filepath.Join(wd, keyringDir)
+	}
+
+	keyringBackend := strings.TrimSpace(os.Getenv("CELESTIA_GO_KEYRING_BACKEND"))
+	if keyringBackend == "" {
+		keyringBackend = keyring.BackendTest
+	}
+
+	defaultKeyName := strings.TrimSpace(os.Getenv("CELESTIA_GO_KEY_NAME"))
+	if defaultKeyName == "" {
+		defaultKeyName = "x402_poster"
+	}
+
+	defaultGasPrice := fallbackGasPrice
+	if raw := strings.TrimSpace(os.Getenv("CELESTIA_GO_DEFAULT_GAS_PRICE")); raw != "" {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse CELESTIA_GO_DEFAULT_GAS_PRICE: %w", err)
+		}
+		defaultGasPrice = parsed
+	}
+
+	daBackend := strings.TrimSpace(os.Getenv("CELESTIA_GO_DA_BACKEND"))
+	if daBackend == "" {
+		daBackend = "celestia"
+	}
+
+	timeout := defaultTimeout
+	if raw := strings.TrimSpace(os.Getenv("CELESTIA_GO_POSTER_TIMEOUT_MS")); raw != "" {
+		timeoutMs, err := time.ParseDuration(raw + "ms")
+		if err != nil {
+			return Config{}, fmt.Errorf("parse CELESTIA_GO_POSTER_TIMEOUT_MS: %w", err)
+		}
+		timeout = timeoutMs
+	}
+
+	return Config{
+		DAURL:           daURL,
+		DAAuthToken:     daToken,
+		CoreGRPCAddr:    coreGRPCAddr,
+		CoreAuthToken:   coreToken,
+		Network:         p2p.Network(network),
+		KeyringDir:      keyringDir,
+		KeyringBackend:  keyringBackend,
+		DefaultKeyName:  defaultKeyName,
+		EnableDATLS:     strings.EqualFold(parsedDAURL.Scheme, "https"),
+		EnableCoreTLS:   strings.EqualFold(parsedDAURL.Scheme, "https"),
+		Timeout:         timeout,
+		DefaultGasPrice: defaultGasPrice,
+		DABackend:       daBackend,
+	}, nil
+}
+
+func deriveTokenFromPath(rawPath string) string {
+	segments := strings.Split(rawPath, "/")
+	for _, segment := range segments {
+		trimmed := strings.TrimSpace(segment)
+		if trimmed != "" {
+			return trimmed
+		}
+	}
+	return ""
+}
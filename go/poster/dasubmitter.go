@@ -0,0 +1,60 @@
+package poster
+
+import (
+	"context"
+	"fmt"
+
+	nodeblob "github.com/celestiaorg/celestia-node/blob"
+	libshare "github.com/celestiaorg/go-square/v3/share"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+// SubmitOptions carries the per-call signing overrides Submit needs beyond
+// the blobs and gas price being posted.
+type SubmitOptions struct {
+	KeyName       string
+	SignerAddress string
+}
+
+// SubmitResult is a backend-agnostic view of the outcome of posting blobs.
+type SubmitResult struct {
+	TxHash string
+	Height int64
+	Code   uint32
+	RawLog string
+}
+
+// DASubmitter abstracts the DA operations the poster needs, so the same
+// binary can drive celestia-node, a generic go-da 0.5 backend, or an
+// in-memory mock, selected via CELESTIA_GO_DA_BACKEND. Not every backend
+// can support every method: a generic go-da endpoint may only expose
+// Submit, in which case Balance and GetProof return an error naming the
+// backend.
+type DASubmitter interface {
+	Balance(ctx context.Context) (*CoinBalance, error)
+	// BalanceForAddress reports the balance of a specific signer, so
+	// callers that resolved a non-default key (e.g. via
+	// NamespaceKeyPolicy) can report a balance for the account that
+	// actually signed rather than the backend-global default. The goda
+	// backend has no notion of balance and returns an error naming the
+	// backend; the mock backend returns its single balance for every
+	// address.
+	BalanceForAddress(ctx context.Context, address string) (*CoinBalance, error)
+	Submit(ctx context.Context, blobs []*libshare.Blob, gasPrice float64, opts SubmitOptions) (*SubmitResult, error)
+	GetProof(ctx context.Context, height uint64, namespace libshare.Namespace, commitment []byte) (*nodeblob.Proof, error)
+	Close() error
+}
+
+// NewDASubmitter constructs the DASubmitter selected by cfg.DABackend.
+func NewDASubmitter(ctx context.Context, cfg Config, kr keyring.Keyring) (DASubmitter, error) {
+	switch cfg.DABackend {
+	case "", "celestia":
+		return newCelestiaSubmitter(ctx, cfg, kr)
+	case "goda":
+		return newGoDASubmitter(ctx, cfg)
+	case "mock":
+		return newMockSubmitter(), nil
+	default:
+		return nil, fmt.Errorf("unsupported CELESTIA_GO_DA_BACKEND %q", cfg.DABackend)
+	}
+}
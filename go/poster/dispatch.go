@@ -0,0 +1,40 @@
+package poster
+
+import (
+	"context"
+	"fmt"
+)
+
+// Dispatch runs a single named action against the service. It is the one
+// place oneshot mode and the JSON-RPC server agree on supported actions, so
+// neither can drift from the other.
+func (s *Service) Dispatch(ctx context.Context, req Request) (Response, error) {
+	if req.Action == "" {
+		req.Action = "status"
+	}
+
+	switch req.Action {
+	case "status":
+		return s.Status(ctx, req)
+	case "submit":
+		return s.Submit(ctx, req)
+	case "submit_batch":
+		return s.SubmitBatch(ctx, req)
+	case "estimate_fee":
+		return s.EstimateFee(ctx, req)
+	case "create_key":
+		return s.CreateKey(ctx, req)
+	case "list_keys":
+		return s.ListKeys(ctx, req)
+	case "rotate_key":
+		return s.RotateKey(ctx, req)
+	case "export_pubkey":
+		return s.ExportPubkey(ctx, req)
+	case "get_proof":
+		return s.GetProof(ctx, req)
+	case "verify_proof":
+		return s.VerifyProof(ctx, req)
+	default:
+		return Response{}, fmt.Errorf("unsupported action %q", req.Action)
+	}
+}
@@ -0,0 +1,65 @@
+package poster
+
+import (
+	"context"
+	"fmt"
+)
+
+// Rough number of blob data bytes that fit in one share of a v0 blob,
+// approximating celestia-app's share layout by the capacity of the first
+// share of a sequence. This is deliberately approximate: estimate_fee only needs a
+// projection, unlike packBlobs' PFB-packing decision, which uses the real
+// libshare.SparseSharesNeeded calculation instead.
+const approxBytesPerShare = 478
+
+// gasPerShare is a rough per-share gas cost, matching the PFB gas
+// consumption model celestia-app uses for blob shares, mirroring the
+// shape of blob.NewSubmitOptions' fee calculation.
+const gasPerShare = 258
+
+// txOverheadGas covers the fixed cost of a PayForBlob tx (signature
+// verification, auth, bank send) on top of the per-share blob cost.
+const txOverheadGas = 75000
+
+func sharesForBlobSize(sizeBytes int) int {
+	if sizeBytes <= 0 {
+		return 0
+	}
+	shares := (sizeBytes + approxBytesPerShare - 1) / approxBytesPerShare
+	if shares == 0 {
+		shares = 1
+	}
+	return shares
+}
+
+// EstimateFee returns a projected fee for posting a blob of
+// req.BlobSizeBytes, based on the gas-price oracle's current sample (or
+// req.GasPrice, if the caller supplies one).
+func (s *Service) EstimateFee(ctx context.Context, req Request) (Response, error) {
+	keyName := s.resolveKeyName(req)
+	address, err := s.addressForKey(keyName)
+	if err != nil {
+		return Response{}, err
+	}
+
+	if req.BlobSizeBytes <= 0 {
+		return Response{}, fmt.Errorf("blob_size_bytes is required for estimate_fee")
+	}
+
+	gasPrice := s.gasOracle.Price()
+	if req.GasPrice != nil {
+		gasPrice = *req.GasPrice
+	}
+
+	shares := sharesForBlobSize(req.BlobSizeBytes)
+	gas := uint64(shares)*gasPerShare + txOverheadGas
+	fee := float64(gas) * gasPrice
+
+	return Response{
+		OK:            true,
+		Mode:          "estimate_fee",
+		PosterAddress: address,
+		KeyName:       keyName,
+		EstimatedFee:  fmt.Sprintf("%.0f", fee),
+	}, nil
+}
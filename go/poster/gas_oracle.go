@@ -0,0 +1,106 @@
+package poster
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	gasestimation "github.com/celestiaorg/celestia-app/v3/app/grpc/gasestimation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const gasOracleSampleInterval = 30 * time.Second
+
+// GasPriceOracle periodically samples the network's recommended minimum
+// gas price from the Core GRPC endpoint, so Submit can use a live floor
+// instead of a stale hardcoded default.
+type GasPriceOracle struct {
+	conn   *grpc.ClientConn
+	client gasestimation.GasEstimatorServiceClient
+
+	mu        sync.RWMutex
+	lastPrice float64
+
+	defaultPrice float64
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewGasPriceOracle dials the Core GRPC endpoint and starts sampling in the
+// background. defaultPrice is used until the first successful sample; a
+// sample that fails or comes back non-positive is ignored and the previous
+// price is kept.
+func NewGasPriceOracle(coreGRPCAddr string, defaultPrice float64) (*GasPriceOracle, error) {
+	conn, err := grpc.NewClient(coreGRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("dial core grpc %q: %w", coreGRPCAddr, err)
+	}
+
+	o := &GasPriceOracle{
+		conn:         conn,
+		client:       gasestimation.NewGasEstimatorServiceClient(conn),
+		lastPrice:    defaultPrice,
+		defaultPrice: defaultPrice,
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+
+	go o.loop()
+
+	return o, nil
+}
+
+func (o *GasPriceOracle) loop() {
+	defer close(o.done)
+
+	o.sample()
+
+	ticker := time.NewTicker(gasOracleSampleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-o.stop:
+			return
+		case <-ticker.C:
+			o.sample()
+		}
+	}
+}
+
+func (o *GasPriceOracle) sample() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := o.client.EstimateGasPrice(ctx, &gasestimation.EstimateGasPriceRequest{
+		TxPriority: gasestimation.TxPriority_TX_PRIORITY_MEDIUM,
+	})
+	if err != nil || resp.GetEstimatedGasPrice() <= 0 {
+		return
+	}
+
+	o.mu.Lock()
+	o.lastPrice = resp.GetEstimatedGasPrice()
+	o.mu.Unlock()
+}
+
+// Price returns the most recently sampled recommended gas price, falling
+// back to the configured default if no sample has succeeded yet.
+func (o *GasPriceOracle) Price() float64 {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	if o.lastPrice <= 0 {
+		return o.defaultPrice
+	}
+	return o.lastPrice
+}
+
+// Close stops the background sampling goroutine and tears down the Core
+// GRPC connection backing the oracle.
+func (o *GasPriceOracle) Close() error {
+	close(o.stop)
+	<-o.done
+	return o.conn.Close()
+}
@@ -0,0 +1,76 @@
+package poster
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	nodeblob "github.com/celestiaorg/celestia-node/blob"
+	libshare "github.com/celestiaorg/go-square/v3/share"
+	da "github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/proxy/jsonrpc"
+)
+
+// godaSubmitter adapts a generic go-da 0.5 backend (a rollup's own DA
+// endpoint, or an alt-DA layer) to DASubmitter, so the poster binary can
+// drive non-celestia backends that only expose Submit(blobs, gasPrice).
+// Balance and GetProof have no equivalent in the go-da DA interface, so
+// they return an explicit unsupported error rather than guessing.
+type godaSubmitter struct {
+	client *jsonrpc.Client
+}
+
+func newGoDASubmitter(ctx context.Context, cfg Config) (*godaSubmitter, error) {
+	client, err := jsonrpc.NewClient(ctx, cfg.DAURL, cfg.DAAuthToken)
+	if err != nil {
+		return nil, fmt.Errorf("dial go-da endpoint %q: %w", cfg.DAURL, err)
+	}
+	return &godaSubmitter{client: client}, nil
+}
+
+func (g *godaSubmitter) Balance(ctx context.Context) (*CoinBalance, error) {
+	return nil, fmt.Errorf("status is not supported by the goda DA backend")
+}
+
+func (g *godaSubmitter) BalanceForAddress(ctx context.Context, address string) (*CoinBalance, error) {
+	return nil, fmt.Errorf("status is not supported by the goda DA backend")
+}
+
+func (g *godaSubmitter) Submit(ctx context.Context, blobs []*libshare.Blob, gasPrice float64, _ SubmitOptions) (*SubmitResult, error) {
+	if len(blobs) == 0 {
+		return nil, fmt.Errorf("no blobs to submit")
+	}
+
+	// The go-da Submit RPC takes one namespace for the whole call, unlike
+	// celestia's PFB which tags each blob with its own namespace. packBlobs
+	// groups blobs purely by share count, so a group may mix namespaces;
+	// reject that case rather than silently posting every blob after the
+	// first under the wrong namespace.
+	namespace := blobs[0].Namespace().Bytes()
+	daBlobs := make([]da.Blob, len(blobs))
+	for i, b := range blobs {
+		if ns := b.Namespace().Bytes(); !bytes.Equal(ns, namespace) {
+			return nil, fmt.Errorf("goda DA backend requires a single namespace per submission, got %x and %x", namespace, ns)
+		}
+		daBlobs[i] = b.Data()
+	}
+
+	ids, err := g.client.DA.Submit(ctx, daBlobs, gasPrice, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("submit to go-da backend: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("go-da backend returned no ids")
+	}
+
+	return &SubmitResult{TxHash: fmt.Sprintf("%x", ids[0])}, nil
+}
+
+func (g *godaSubmitter) GetProof(ctx context.Context, height uint64, namespace libshare.Namespace, commitment []byte) (*nodeblob.Proof, error) {
+	return nil, fmt.Errorf("get_proof is not supported by the goda DA backend")
+}
+
+func (g *godaSubmitter) Close() error {
+	g.client.Close()
+	return nil
+}
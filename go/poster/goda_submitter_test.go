@@ -0,0 +1,29 @@
+package poster
+
+import (
+	"context"
+	"testing"
+
+	libshare "github.com/celestiaorg/go-square/v3/share"
+)
+
+func TestGodaSubmitterRejectsMixedNamespaces(t *testing.T) {
+	ns1 := testNamespace(t)
+	ns2, err := libshare.NewV0Namespace([]byte("other-goda"))
+	if err != nil {
+		t.Fatalf("build namespace: %v", err)
+	}
+	b1, err := libshare.NewV0Blob(ns1, []byte("hello"))
+	if err != nil {
+		t.Fatalf("build blob: %v", err)
+	}
+	b2, err := libshare.NewV0Blob(ns2, []byte("world"))
+	if err != nil {
+		t.Fatalf("build blob: %v", err)
+	}
+
+	g := &godaSubmitter{}
+	if _, err := g.Submit(context.Background(), []*libshare.Blob{b1, b2}, 0.002, SubmitOptions{}); err == nil {
+		t.Fatal("expected Submit to reject a group mixing namespaces")
+	}
+}
@@ -0,0 +1,125 @@
+package poster
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+// KeyPool tracks the signer keys available to the poster and how to pick
+// one for a given namespace, independent of a caller naming a single key
+// explicitly via Request.KeyName. This lets an x402 facilitator isolate
+// funds per merchant namespace and rotate hot wallets without a redeploy.
+type KeyPool struct {
+	mu             sync.Mutex
+	names          []string
+	rrCursor       int
+	lastUsedHeight map[string]uint64
+}
+
+func newKeyPool(initial ...string) *KeyPool {
+	return &KeyPool{
+		names:          append([]string(nil), initial...),
+		lastUsedHeight: make(map[string]uint64),
+	}
+}
+
+// newKeyPoolFromKeyring seeds the pool from every key already present in
+// kr, so create_key/rotate_key results survive a process restart instead of
+// being forgotten: KeyringDir persists across restarts but an in-memory
+// pool built from just defaultKeyName does not, leaving list_keys and the
+// namespace_hash/round_robin policies unable to see keys that are still
+// live in the keyring.
+func newKeyPoolFromKeyring(kr keyring.Keyring, defaultKeyName string) (*KeyPool, error) {
+	records, err := kr.List()
+	if err != nil {
+		return nil, fmt.Errorf("list keyring records: %w", err)
+	}
+
+	pool := newKeyPool()
+	haveDefault := false
+	for _, rec := range records {
+		pool.Add(rec.Name)
+		if rec.Name == defaultKeyName {
+			haveDefault = true
+		}
+	}
+	if defaultKeyName != "" && !haveDefault {
+		pool.Add(defaultKeyName)
+	}
+	return pool, nil
+}
+
+// Add registers a newly created key with the pool.
+func (p *KeyPool) Add(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.names = append(p.names, name)
+}
+
+// Remove retires a key from the pool, e.g. after rotate_key.
+func (p *KeyPool) Remove(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, n := range p.names {
+		if n == name {
+			p.names = append(p.names[:i], p.names[i+1:]...)
+			break
+		}
+	}
+	delete(p.lastUsedHeight, name)
+}
+
+// Names returns a snapshot of the pool's current key names.
+func (p *KeyPool) Names() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.names...)
+}
+
+// NextRoundRobin returns the next key name in round-robin order, cycling
+// back to the start of the pool. It returns fallback if the pool is empty.
+func (p *KeyPool) NextRoundRobin(fallback string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.names) == 0 {
+		return fallback
+	}
+	name := p.names[p.rrCursor%len(p.names)]
+	p.rrCursor++
+	return name
+}
+
+// ForNamespace deterministically selects a key for a namespace by hashing
+// the namespace ID, so the same namespace always lands on the same key. It
+// returns fallback if the pool is empty.
+func (p *KeyPool) ForNamespace(namespaceIDB64, fallback string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.names) == 0 {
+		return fallback
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(namespaceIDB64))
+	return p.names[int(h.Sum32())%len(p.names)]
+}
+
+// MarkUsed records the chain height at which name last signed a tx.
+func (p *KeyPool) MarkUsed(name string, height uint64) {
+	if height == 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lastUsedHeight[name] = height
+}
+
+// LastUsedHeight returns the height name last signed a tx at, or 0 if it
+// has never been used.
+func (p *KeyPool) LastUsedHeight(name string) uint64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.lastUsedHeight[name]
+}
@@ -0,0 +1,57 @@
+package poster
+
+import "testing"
+
+func TestKeyPoolNextRoundRobinCycles(t *testing.T) {
+	p := newKeyPool("a", "b", "c")
+	got := []string{
+		p.NextRoundRobin("fallback"),
+		p.NextRoundRobin("fallback"),
+		p.NextRoundRobin("fallback"),
+		p.NextRoundRobin("fallback"),
+	}
+	want := []string{"a", "b", "c", "a"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("call %d: got %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestKeyPoolNextRoundRobinEmptyFallback(t *testing.T) {
+	p := newKeyPool()
+	if got := p.NextRoundRobin("fallback"); got != "fallback" {
+		t.Fatalf("expected fallback for an empty pool, got %q", got)
+	}
+}
+
+func TestKeyPoolForNamespaceIsDeterministic(t *testing.T) {
+	p := newKeyPool("a", "b", "c")
+	first := p.ForNamespace("namespace-one", "fallback")
+	second := p.ForNamespace("namespace-one", "fallback")
+	if first != second {
+		t.Fatalf("expected the same namespace to always select the same key, got %q then %q", first, second)
+	}
+}
+
+func TestKeyPoolForNamespaceEmptyFallback(t *testing.T) {
+	p := newKeyPool()
+	if got := p.ForNamespace("namespace-one", "fallback"); got != "fallback" {
+		t.Fatalf("expected fallback for an empty pool, got %q", got)
+	}
+}
+
+func TestKeyPoolRemove(t *testing.T) {
+	p := newKeyPool("a", "b")
+	p.MarkUsed("a", 10)
+	p.Remove("a")
+
+	for _, name := range p.Names() {
+		if name == "a" {
+			t.Fatal("expected \"a\" to be removed from the pool")
+		}
+	}
+	if got := p.LastUsedHeight("a"); got != 0 {
+		t.Fatalf("expected LastUsedHeight to be cleared after Remove, got %d", got)
+	}
+}
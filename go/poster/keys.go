@@ -0,0 +1,113 @@
+package poster
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateKey provisions a new signer key in the poster's keyring and adds
+// it to the key pool, so operators can grow the signer set without shell
+// access to cel-key.
+func (s *Service) CreateKey(ctx context.Context, req Request) (Response, error) {
+	name := req.NewKeyName
+	if name == "" {
+		return Response{}, fmt.Errorf("new_key_name is required for create_key")
+	}
+
+	record, _, err := s.kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	if err != nil {
+		return Response{}, fmt.Errorf("create key %q: %w", name, err)
+	}
+	address, err := record.GetAddress()
+	if err != nil {
+		return Response{}, fmt.Errorf("get address for new key %q: %w", name, err)
+	}
+
+	s.keyPool.Add(name)
+
+	return Response{
+		OK:            true,
+		Mode:          "create_key",
+		PosterAddress: address.String(),
+		KeyName:       name,
+	}, nil
+}
+
+// ListKeys reports every key in the pool along with its current balance
+// and the height it last signed a tx at.
+func (s *Service) ListKeys(ctx context.Context, req Request) (Response, error) {
+	var keys []KeyRecord
+	for _, name := range s.keyPool.Names() {
+		keyInfo, err := s.kr.Key(name)
+		if err != nil {
+			return Response{}, fmt.Errorf("load key %q: %w", name, err)
+		}
+		address, err := keyInfo.GetAddress()
+		if err != nil {
+			return Response{}, fmt.Errorf("get address for key %q: %w", name, err)
+		}
+
+		rec := KeyRecord{
+			Name:           name,
+			Address:        address.String(),
+			LastUsedHeight: s.keyPool.LastUsedHeight(name),
+		}
+		if s.cl != nil {
+			if bal, err := s.cl.State.BalanceForAddress(ctx, address); err == nil {
+				rec.Balance = &CoinBalance{Denom: bal.Denom, Amount: bal.Amount.String()}
+			}
+		}
+		keys = append(keys, rec)
+	}
+
+	return Response{OK: true, Mode: "list_keys", Keys: keys}, nil
+}
+
+// RotateKey provisions a replacement key and retires req.RetireKeyName (or
+// req.KeyName) from the pool, so a hot wallet can be swapped without a
+// redeploy.
+func (s *Service) RotateKey(ctx context.Context, req Request) (Response, error) {
+	retire := req.RetireKeyName
+	if retire == "" {
+		retire = req.KeyName
+	}
+	if retire == "" {
+		return Response{}, fmt.Errorf("retire_key_name (or key_name) is required for rotate_key")
+	}
+
+	resp, err := s.CreateKey(ctx, req)
+	if err != nil {
+		return Response{}, err
+	}
+
+	s.keyPool.Remove(retire)
+	resp.Mode = "rotate_key"
+	return resp, nil
+}
+
+// ExportPubkey returns the base64-encoded public key for the resolved
+// signer key, so a counterparty can verify signatures without shell access
+// to the keyring.
+func (s *Service) ExportPubkey(ctx context.Context, req Request) (Response, error) {
+	keyName := s.resolveKeyName(req)
+	keyInfo, err := s.kr.Key(keyName)
+	if err != nil {
+		return Response{}, fmt.Errorf("load key %q: %w", keyName, err)
+	}
+	pubKey, err := keyInfo.GetPubKey()
+	if err != nil {
+		return Response{}, fmt.Errorf("get pubkey for key %q: %w", keyName, err)
+	}
+
+	return Response{
+		OK:        true,
+		Mode:      "export_pubkey",
+		KeyName:   keyName,
+		PubKeyB64: base64.StdEncoding.EncodeToString(pubKey.Bytes()),
+	}, nil
+}
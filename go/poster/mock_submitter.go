@@ -0,0 +1,57 @@
+package poster
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	nodeblob "github.com/celestiaorg/celestia-node/blob"
+	libshare "github.com/celestiaorg/go-square/v3/share"
+)
+
+// mockSubmitter is an in-memory DASubmitter selected via
+// CELESTIA_GO_DA_BACKEND=mock, so the poster can be exercised end-to-end
+// without a live bridge node or Core GRPC endpoint.
+type mockSubmitter struct {
+	mu      sync.Mutex
+	height  int64
+	posted  int
+	balance CoinBalance
+}
+
+func newMockSubmitter() *mockSubmitter {
+	return &mockSubmitter{balance: CoinBalance{Denom: "utia", Amount: "1000000000"}}
+}
+
+func (m *mockSubmitter) Balance(ctx context.Context) (*CoinBalance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	bal := m.balance
+	return &bal, nil
+}
+
+// BalanceForAddress returns the same balance as Balance, since the mock
+// backend doesn't track separate funds per signer.
+func (m *mockSubmitter) BalanceForAddress(ctx context.Context, address string) (*CoinBalance, error) {
+	return m.Balance(ctx)
+}
+
+func (m *mockSubmitter) Submit(ctx context.Context, blobs []*libshare.Blob, gasPrice float64, _ SubmitOptions) (*SubmitResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.height++
+	m.posted += len(blobs)
+	return &SubmitResult{
+		TxHash: fmt.Sprintf("mock-tx-%d", m.posted),
+		Height: m.height,
+		Code:   0,
+	}, nil
+}
+
+func (m *mockSubmitter) GetProof(ctx context.Context, height uint64, namespace libshare.Namespace, commitment []byte) (*nodeblob.Proof, error) {
+	return nil, fmt.Errorf("get_proof is not supported by the mock DA backend")
+}
+
+func (m *mockSubmitter) Close() error {
+	return nil
+}
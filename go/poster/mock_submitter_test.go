@@ -0,0 +1,84 @@
+package poster
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	libshare "github.com/celestiaorg/go-square/v3/share"
+)
+
+func testNamespace(t *testing.T) libshare.Namespace {
+	t.Helper()
+	ns, err := libshare.NewV0Namespace([]byte("mock-ns"))
+	if err != nil {
+		t.Fatalf("build namespace: %v", err)
+	}
+	return ns
+}
+
+// namespaceB64 base64-encodes a 10-byte v0 sub-namespace ID, the shortest
+// form ParseNamespace accepts, for building Request payloads in tests.
+func namespaceB64(id string) string {
+	padded := (id + "0123456789")[:10]
+	return base64.StdEncoding.EncodeToString([]byte(padded))
+}
+
+func TestMockSubmitterSubmitIncrementsHeight(t *testing.T) {
+	m := newMockSubmitter()
+	namespace := testNamespace(t)
+	blob, err := libshare.NewV0Blob(namespace, []byte("hello"))
+	if err != nil {
+		t.Fatalf("build blob: %v", err)
+	}
+
+	first, err := m.Submit(context.Background(), []*libshare.Blob{blob}, 0.002, SubmitOptions{})
+	if err != nil {
+		t.Fatalf("Submit: %v", err)
+	}
+	second, err := m.Submit(context.Background(), []*libshare.Blob{blob, blob}, 0.002, SubmitOptions{})
+	if err != nil {
+		t.Fatalf("Submit: %v", err)
+	}
+
+	if first.Code != 0 || second.Code != 0 {
+		t.Fatalf("expected code 0, got %d and %d", first.Code, second.Code)
+	}
+	if second.Height != first.Height+1 {
+		t.Fatalf("expected each Submit call to advance the mock height by 1, got %d then %d", first.Height, second.Height)
+	}
+	if first.TxHash == second.TxHash {
+		t.Fatalf("expected distinct tx hashes, got %q for both", first.TxHash)
+	}
+}
+
+func TestMockSubmitterBalance(t *testing.T) {
+	m := newMockSubmitter()
+	bal, err := m.Balance(context.Background())
+	if err != nil {
+		t.Fatalf("Balance: %v", err)
+	}
+	if bal.Denom != "utia" || bal.Amount == "" {
+		t.Fatalf("expected a non-empty utia balance, got %+v", bal)
+	}
+}
+
+func TestMockSubmitterGetProofUnsupported(t *testing.T) {
+	m := newMockSubmitter()
+	if _, err := m.GetProof(context.Background(), 1, testNamespace(t), nil); err == nil {
+		t.Fatal("expected GetProof to error on the mock backend")
+	}
+}
+
+func TestNewDASubmitterMock(t *testing.T) {
+	cfg := Config{DABackend: "mock"}
+	da, err := NewDASubmitter(context.Background(), cfg, nil)
+	if err != nil {
+		t.Fatalf("NewDASubmitter: %v", err)
+	}
+	defer da.Close()
+
+	if _, ok := da.(*mockSubmitter); !ok {
+		t.Fatalf("expected a *mockSubmitter, got %T", da)
+	}
+}
@@ -0,0 +1,35 @@
+package poster
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	libshare "github.com/celestiaorg/go-square/v3/share"
+)
+
+// ParseNamespace decodes a base64 namespace ID in any of the formats x402
+// callers have historically sent: a full 29-byte versioned namespace, a
+// 28-byte namespace missing its version byte, or a 10-byte v0 sub-namespace
+// ID.
+func ParseNamespace(namespaceIDB64 string) (libshare.Namespace, error) {
+	namespaceBytes, err := base64.StdEncoding.DecodeString(namespaceIDB64)
+	if err != nil {
+		return libshare.Namespace{}, fmt.Errorf("decode base64: %w", err)
+	}
+
+	switch len(namespaceBytes) {
+	case 29:
+		return libshare.NewNamespaceFromBytes(namespaceBytes)
+	case 28:
+		// Backward-compatible: JSON-RPC payloads may omit the 1-byte namespace version.
+		versioned := make([]byte, 0, 29)
+		versioned = append(versioned, 0)
+		versioned = append(versioned, namespaceBytes...)
+		return libshare.NewNamespaceFromBytes(versioned)
+	case 10:
+		// Support plain v0 sub-namespace IDs.
+		return libshare.NewV0Namespace(namespaceBytes)
+	default:
+		return libshare.NewNamespaceFromBytes(namespaceBytes)
+	}
+}
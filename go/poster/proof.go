@@ -0,0 +1,136 @@
+package poster
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-node/blob"
+	libshare "github.com/celestiaorg/go-square/v3/share"
+)
+
+// GetProof fetches the NMT inclusion proof and DataAvailabilityHeader row
+// roots for a previously posted blob, identified either by req.TxHash or
+// by req.Height+req.CommitmentB64. The result lets a payer independently
+// verify a "posted to Celestia" claim rather than trusting the poster's
+// tx hash alone.
+func (s *Service) GetProof(ctx context.Context, req Request) (Response, error) {
+	if req.NamespaceIDB64 == "" {
+		return Response{}, fmt.Errorf("namespace_id_b64 is required for get_proof")
+	}
+	namespace, err := ParseNamespace(req.NamespaceIDB64)
+	if err != nil {
+		return Response{}, fmt.Errorf("parse namespace_id_b64: %w", err)
+	}
+
+	height, commitment, err := s.resolveHeightAndCommitment(ctx, req, namespace)
+	if err != nil {
+		return Response{}, err
+	}
+
+	proof, err := s.da.GetProof(ctx, uint64(height), namespace, commitment)
+	if err != nil {
+		return Response{}, fmt.Errorf("get inclusion proof at height %d: %w", height, err)
+	}
+
+	if s.cl == nil {
+		return Response{}, fmt.Errorf("row roots require the celestia DA backend, got %q", s.cfg.DABackend)
+	}
+	header, err := s.cl.Header.GetByHeight(ctx, uint64(height))
+	if err != nil {
+		return Response{}, fmt.Errorf("get header at height %d: %w", height, err)
+	}
+
+	rowRoots := make([]string, len(header.DAH.RowRoots))
+	for i, root := range header.DAH.RowRoots {
+		rowRoots[i] = base64.StdEncoding.EncodeToString(root)
+	}
+
+	proofBytes, err := json.Marshal(proof)
+	if err != nil {
+		return Response{}, fmt.Errorf("encode proof: %w", err)
+	}
+
+	return Response{
+		OK:            true,
+		Mode:          "get_proof",
+		Height:        uint64(height),
+		CommitmentB64: base64.StdEncoding.EncodeToString(commitment),
+		ProofB64:      base64.StdEncoding.EncodeToString(proofBytes),
+		RowRootsB64:   rowRoots,
+	}, nil
+}
+
+// VerifyProof re-runs the NMT inclusion check locally against a supplied
+// proof, namespace, and commitment, so a client holding a get_proof result
+// can confirm inclusion without trusting the poster that generated it.
+func (s *Service) VerifyProof(ctx context.Context, req Request) (Response, error) {
+	if req.NamespaceIDB64 == "" || req.CommitmentB64 == "" || req.ProofB64 == "" || req.Height == 0 {
+		return Response{}, fmt.Errorf("namespace_id_b64, height, commitment_b64, and proof_b64 are required for verify_proof")
+	}
+
+	namespace, err := ParseNamespace(req.NamespaceIDB64)
+	if err != nil {
+		return Response{}, fmt.Errorf("parse namespace_id_b64: %w", err)
+	}
+	commitment, err := base64.StdEncoding.DecodeString(req.CommitmentB64)
+	if err != nil {
+		return Response{}, fmt.Errorf("decode commitment_b64: %w", err)
+	}
+	proofBytes, err := base64.StdEncoding.DecodeString(req.ProofB64)
+	if err != nil {
+		return Response{}, fmt.Errorf("decode proof_b64: %w", err)
+	}
+
+	var proof blob.Proof
+	if err := json.Unmarshal(proofBytes, &proof); err != nil {
+		return Response{}, fmt.Errorf("decode proof: %w", err)
+	}
+
+	if s.cl == nil {
+		return Response{}, fmt.Errorf("verify_proof requires the celestia DA backend, got %q", s.cfg.DABackend)
+	}
+	included, err := s.cl.Blob.Included(ctx, uint64(req.Height), namespace, &proof, commitment)
+	if err != nil {
+		return Response{}, fmt.Errorf("verify inclusion proof: %w", err)
+	}
+
+	return Response{
+		OK:       true,
+		Mode:     "verify_proof",
+		Height:   uint64(req.Height),
+		Verified: &included,
+	}, nil
+}
+
+// resolveHeightAndCommitment resolves the height and commitment a get_proof
+// call should fetch a proof for. An explicit height+commitment_b64 is
+// trusted as-is. Resolving by tx_hash looks the commitment up in
+// s.commitments, which was populated from the blob bytes at submit time, so
+// it can never return the wrong co-located blob's commitment the way a
+// namespace+height chain scan could. This only resolves tx hashes this
+// process itself submitted and still has tracked: a restart, an old tx that
+// aged out of the bounded index, or a tx submitted by another poster
+// process all require the caller to pass height+commitment_b64 instead,
+// since there is no way to recover which blob a tx posted without either
+// the commitment computed at submit time or decoding the tx body itself.
+func (s *Service) resolveHeightAndCommitment(ctx context.Context, req Request, namespace libshare.Namespace) (int64, []byte, error) {
+	if req.Height > 0 && req.CommitmentB64 != "" {
+		commitment, err := base64.StdEncoding.DecodeString(req.CommitmentB64)
+		if err != nil {
+			return 0, nil, fmt.Errorf("decode commitment_b64: %w", err)
+		}
+		return req.Height, commitment, nil
+	}
+
+	if req.TxHash == "" {
+		return 0, nil, fmt.Errorf("tx_hash or height+commitment_b64 is required for get_proof")
+	}
+
+	height, commitment, ok, err := s.commitments.lookup(req.TxHash, namespace)
+	if !ok {
+		return 0, nil, fmt.Errorf("tx %q is not tracked by this poster; pass height and commitment_b64 explicitly", req.TxHash)
+	}
+	return height, commitment, err
+}
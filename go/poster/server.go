@@ -0,0 +1,130 @@
+package poster
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+)
+
+// Server exposes a Service over JSON-RPC 2.0, mirroring the shape of
+// celestia-node's own blob API: one HTTP endpoint, one method per poster
+// action, params and result are the same Request/Response types oneshot
+// mode uses on stdin/stdout.
+type Server struct {
+	svc *Service
+}
+
+// NewServer wraps svc for HTTP/JSON-RPC serving.
+func NewServer(svc *Service) *Server {
+	return &Server{svc: svc}
+}
+
+type jsonrpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonrpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  *Response       `json:"result,omitempty"`
+	Error   *jsonrpcError   `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+// ServeHTTP implements http.Handler. It accepts a single JSON-RPC 2.0
+// request per call; the method name is the poster action and params is a
+// Request body. The action whitelist lives solely in Dispatch's switch, so
+// an unsupported method falls through to the same "unsupported action"
+// error oneshot mode returns.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var rpcReq jsonrpcRequest
+	if err := json.NewDecoder(r.Body).Decode(&rpcReq); err != nil {
+		s.writeError(w, nil, fmt.Errorf("decode json-rpc request: %w", err))
+		return
+	}
+
+	var req Request
+	if len(rpcReq.Params) > 0 {
+		if err := json.Unmarshal(rpcReq.Params, &req); err != nil {
+			s.writeError(w, rpcReq.ID, fmt.Errorf("decode params: %w", err))
+			return
+		}
+	}
+	req.Action = rpcReq.Method
+
+	resp, err := s.svc.Dispatch(r.Context(), req)
+	if err != nil {
+		s.writeError(w, rpcReq.ID, err)
+		return
+	}
+
+	s.writeResult(w, rpcReq.ID, resp)
+}
+
+func (s *Server) writeResult(w http.ResponseWriter, id json.RawMessage, resp Response) {
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(jsonrpcResponse{JSONRPC: "2.0", Result: &resp, ID: id})
+}
+
+func (s *Server) writeError(w http.ResponseWriter, id json.RawMessage, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(jsonrpcResponse{
+		JSONRPC: "2.0",
+		Error:   &jsonrpcError{Code: -32000, Message: err.Error()},
+		ID:      id,
+	})
+}
+
+// ListenUnix binds a Unix domain socket for local, trust-the-caller access
+// (e.g. an x402 facilitator running on the same host), separate from the
+// TCP listener used for remote callers.
+func ListenUnix(socketPath string) (net.Listener, error) {
+	if err := removeStaleSocket(socketPath); err != nil {
+		return nil, err
+	}
+	l, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("listen on unix socket %q: %w", socketPath, err)
+	}
+	return l, nil
+}
+
+// removeStaleSocket clears socketPath before binding, but only if it is
+// already a Unix socket (left behind by a prior process that didn't shut
+// down cleanly) or doesn't exist. A misconfigured socketPath pointing at a
+// directory or unrelated file is left untouched rather than recursively
+// deleted.
+func removeStaleSocket(socketPath string) error {
+	info, err := os.Lstat(socketPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("stat unix socket path %q: %w", socketPath, err)
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("refusing to remove %q: not a unix socket", socketPath)
+	}
+	if err := os.Remove(socketPath); err != nil {
+		return fmt.Errorf("remove stale socket %q: %w", socketPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package poster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-node/api/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+// Service owns a single long-lived DA backend connection, keyring, and
+// gas-price oracle, so that repeated calls to Status/Submit/etc. don't each
+// pay the cost of re-establishing them. Construct one with NewService at
+// process start and reuse it for the lifetime of the daemon.
+type Service struct {
+	cfg Config
+	kr  keyring.Keyring
+	da  DASubmitter
+	// cl is non-nil only when da is backed by celestia-node (the default
+	// backend); it backs the celestia-specific extras (header row roots,
+	// per-key balances, inclusion verification) that have no equivalent in
+	// the generic DASubmitter interface.
+	cl        *client.Client
+	gasOracle *GasPriceOracle
+	keyPool   *KeyPool
+	// commitments records the locally computed commitment of every blob
+	// this process has submitted, so get_proof can resolve a tx_hash
+	// without an unordered chain query. See commitmentIndex.
+	commitments *commitmentIndex
+}
+
+// NewService opens the keyring and connects the configured DA backend
+// once. The returned Service is safe for concurrent use by multiple
+// callers.
+func NewService(ctx context.Context, cfg Config) (*Service, error) {
+	kr, err := client.KeyringWithNewKey(client.KeyringConfig{
+		KeyName:     cfg.DefaultKeyName,
+		BackendName: cfg.KeyringBackend,
+	}, cfg.KeyringDir)
+	if err != nil {
+		return nil, fmt.Errorf("open keyring in %q: %w", cfg.KeyringDir, err)
+	}
+
+	da, err := NewDASubmitter(ctx, cfg, kr)
+	if err != nil {
+		return nil, fmt.Errorf("init DA backend %q: %w", cfg.DABackend, err)
+	}
+
+	var cl *client.Client
+	if celestia, ok := da.(*celestiaSubmitter); ok {
+		cl = celestia.cl
+	}
+
+	gasOracle, err := NewGasPriceOracle(cfg.CoreGRPCAddr, cfg.DefaultGasPrice)
+	if err != nil {
+		return nil, fmt.Errorf("init gas price oracle: %w", err)
+	}
+
+	keyPool, err := newKeyPoolFromKeyring(kr, cfg.DefaultKeyName)
+	if err != nil {
+		return nil, fmt.Errorf("init key pool: %w", err)
+	}
+
+	return &Service{
+		cfg:         cfg,
+		kr:          kr,
+		da:          da,
+		cl:          cl,
+		gasOracle:   gasOracle,
+		keyPool:     keyPool,
+		commitments: newCommitmentIndex(),
+	}, nil
+}
+
+// Close releases the underlying DA backend and gas oracle connections.
+func (s *Service) Close() error {
+	_ = s.gasOracle.Close()
+	return s.da.Close()
+}
+
+// resolveKeyName picks the signer key a request should use: an explicit
+// KeyName wins, otherwise NamespaceKeyPolicy selects one from the key
+// pool, otherwise the poster's configured default key.
+func (s *Service) resolveKeyName(req Request) string {
+	if req.KeyName != "" {
+		return req.KeyName
+	}
+	switch req.NamespaceKeyPolicy {
+	case "round_robin":
+		return s.keyPool.NextRoundRobin(s.cfg.DefaultKeyName)
+	case "namespace_hash":
+		return s.keyPool.ForNamespace(req.NamespaceIDB64, s.cfg.DefaultKeyName)
+	default:
+		return s.cfg.DefaultKeyName
+	}
+}
+
+func (s *Service) addressForKey(keyName string) (string, error) {
+	keyInfo, err := s.kr.Key(keyName)
+	if err != nil {
+		return "", fmt.Errorf("load key %q: %w", keyName, err)
+	}
+	address, err := keyInfo.GetAddress()
+	if err != nil {
+		return "", fmt.Errorf("get key address: %w", err)
+	}
+	return address.String(), nil
+}
+
+// Status reports the poster's current on-chain balance.
+func (s *Service) Status(ctx context.Context, req Request) (Response, error) {
+	keyName := s.resolveKeyName(req)
+	address, err := s.addressForKey(keyName)
+	if err != nil {
+		return Response{}, err
+	}
+
+	resp := Response{OK: true, Mode: "status", PosterAddress: address, KeyName: keyName}
+
+	bal, err := s.da.BalanceForAddress(ctx, address)
+	if err != nil {
+		return Response{}, fmt.Errorf("read poster balance: %w", err)
+	}
+	resp.Balance = bal
+	return resp, nil
+}
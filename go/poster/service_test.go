@@ -0,0 +1,136 @@
+package poster
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+)
+
+// newMockService builds a real Service via LoadConfig/NewService backed by
+// CELESTIA_GO_DA_BACKEND=mock, so Dispatch can be exercised end-to-end
+// (keyring, key pool, gas oracle, and all) without a live bridge node.
+func newMockService(t *testing.T) *Service {
+	t.Helper()
+	t.Setenv("CELESTIA_GO_DA_URL", "http://127.0.0.1:26658/test-token")
+	t.Setenv("CELESTIA_GO_DA_BACKEND", "mock")
+	t.Setenv("CELESTIA_GO_KEYRING_DIR", t.TempDir())
+	t.Setenv("CELESTIA_GO_KEYRING_BACKEND", "test")
+	t.Setenv("CELESTIA_GO_KEY_NAME", "mock-poster")
+	t.Setenv("CELESTIA_GO_DEFAULT_GAS_PRICE", "0.002")
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	svc, err := NewService(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	t.Cleanup(func() { _ = svc.Close() })
+	return svc
+}
+
+func TestServiceDispatchStatus(t *testing.T) {
+	svc := newMockService(t)
+	resp, err := svc.Dispatch(context.Background(), Request{Action: "status"})
+	if err != nil {
+		t.Fatalf("Dispatch status: %v", err)
+	}
+	if !resp.OK || resp.Balance == nil {
+		t.Fatalf("expected an OK status with a balance, got %+v", resp)
+	}
+}
+
+func TestServiceDispatchSubmit(t *testing.T) {
+	svc := newMockService(t)
+	resp, err := svc.Dispatch(context.Background(), Request{
+		Action:         "submit",
+		NamespaceIDB64: namespaceB64("submit"),
+		DataB64:        base64.StdEncoding.EncodeToString([]byte("hello")),
+	})
+	if err != nil {
+		t.Fatalf("Dispatch submit: %v", err)
+	}
+	if !resp.OK || resp.TxHash == "" || resp.BlobCommitmentB64 == "" {
+		t.Fatalf("expected a posted blob with a commitment, got %+v", resp)
+	}
+}
+
+func TestServiceDispatchSubmitBatchPacksMultipleBlobsIntoOneGroup(t *testing.T) {
+	svc := newMockService(t)
+	resp, err := svc.Dispatch(context.Background(), Request{
+		Action: "submit_batch",
+		Blobs: []BlobInput{
+			{NamespaceIDB64: namespaceB64("a"), DataB64: base64.StdEncoding.EncodeToString([]byte("blob-a"))},
+			{NamespaceIDB64: namespaceB64("b"), DataB64: base64.StdEncoding.EncodeToString([]byte("blob-b"))},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Dispatch submit_batch: %v", err)
+	}
+	if !resp.OK || resp.BlobsPosted != 2 || len(resp.TxHashes) != 1 || len(resp.BlobCommitments) != 2 {
+		t.Fatalf("expected both small blobs packed into a single PFB group, got %+v", resp)
+	}
+}
+
+func TestServiceDispatchEstimateFee(t *testing.T) {
+	svc := newMockService(t)
+	resp, err := svc.Dispatch(context.Background(), Request{Action: "estimate_fee", BlobSizeBytes: 1000})
+	if err != nil {
+		t.Fatalf("Dispatch estimate_fee: %v", err)
+	}
+	if !resp.OK || resp.EstimatedFee == "" {
+		t.Fatalf("expected an estimated fee, got %+v", resp)
+	}
+}
+
+func TestServiceDispatchNamespaceKeyPolicyRoundRobin(t *testing.T) {
+	svc := newMockService(t)
+	ctx := context.Background()
+
+	if _, err := svc.Dispatch(ctx, Request{Action: "create_key", NewKeyName: "second-key"}); err != nil {
+		t.Fatalf("Dispatch create_key: %v", err)
+	}
+
+	req := Request{Action: "status", NamespaceKeyPolicy: "round_robin"}
+	first, err := svc.Dispatch(ctx, req)
+	if err != nil {
+		t.Fatalf("Dispatch status: %v", err)
+	}
+	second, err := svc.Dispatch(ctx, req)
+	if err != nil {
+		t.Fatalf("Dispatch status: %v", err)
+	}
+	if first.KeyName == second.KeyName {
+		t.Fatalf("expected round_robin to alternate keys across calls, got %q both times", first.KeyName)
+	}
+}
+
+func TestServiceDispatchNamespaceKeyPolicyHashIsDeterministic(t *testing.T) {
+	svc := newMockService(t)
+	ctx := context.Background()
+
+	if _, err := svc.Dispatch(ctx, Request{Action: "create_key", NewKeyName: "second-key"}); err != nil {
+		t.Fatalf("Dispatch create_key: %v", err)
+	}
+
+	req := Request{Action: "status", NamespaceKeyPolicy: "namespace_hash", NamespaceIDB64: namespaceB64("fixed")}
+	first, err := svc.Dispatch(ctx, req)
+	if err != nil {
+		t.Fatalf("Dispatch status: %v", err)
+	}
+	second, err := svc.Dispatch(ctx, req)
+	if err != nil {
+		t.Fatalf("Dispatch status: %v", err)
+	}
+	if first.KeyName != second.KeyName {
+		t.Fatalf("expected namespace_hash to pick the same key for the same namespace, got %q then %q", first.KeyName, second.KeyName)
+	}
+}
+
+func TestServiceDispatchUnsupportedAction(t *testing.T) {
+	svc := newMockService(t)
+	if _, err := svc.Dispatch(context.Background(), Request{Action: "not_a_real_action"}); err == nil {
+		t.Fatal("expected an error for an unsupported action")
+	}
+}
@@ -0,0 +1,179 @@
+package poster
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	nodeblob "github.com/celestiaorg/celestia-node/blob"
+	libshare "github.com/celestiaorg/go-square/v3/share"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxGasBumpAttempts bounds how many times Submit will retry a tx with
+	// a bumped gas price before giving up: it then returns the last response
+	// for a fee rejection, or an error if every attempt timed out.
+	maxGasBumpAttempts = 5
+	// gasBumpFactor is applied multiplicatively on each retry.
+	gasBumpFactor = 1.3
+)
+
+// Submit posts a single blob as a pay-for-blob transaction. If the network
+// rejects the tx for insufficient fee, Submit retries with an
+// exponentially bumped gas price up to maxGasBumpAttempts times. If the tx
+// times out waiting in the mempool instead, Submit first checks whether it
+// landed anyway before retrying, since SubmitPayForBlob signs a new tx on
+// every call and a blind retry could double-post the blob.
+func (s *Service) Submit(ctx context.Context, req Request) (Response, error) {
+	keyName := s.resolveKeyName(req)
+	address, err := s.addressForKey(keyName)
+	if err != nil {
+		return Response{}, err
+	}
+
+	resp := Response{OK: true, Mode: "submit", PosterAddress: address, KeyName: keyName}
+
+	if req.NamespaceIDB64 == "" {
+		return Response{}, fmt.Errorf("namespace_id_b64 is required for submit")
+	}
+	if req.DataB64 == "" {
+		return Response{}, fmt.Errorf("data_b64 is required for submit")
+	}
+
+	namespace, err := ParseNamespace(req.NamespaceIDB64)
+	if err != nil {
+		return Response{}, fmt.Errorf("parse namespace_id_b64: %w", err)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(req.DataB64)
+	if err != nil {
+		return Response{}, fmt.Errorf("decode data_b64: %w", err)
+	}
+	nb, err := nodeblob.NewBlobV0(namespace, data)
+	if err != nil {
+		return Response{}, fmt.Errorf("build blob: %w", err)
+	}
+
+	gasPrice := s.gasOracle.Price()
+	if req.GasPrice != nil {
+		gasPrice = *req.GasPrice
+	}
+
+	opts := SubmitOptions{KeyName: keyName, SignerAddress: req.SignerAddress}
+
+	var result *SubmitResult
+	attempts := 0
+	for {
+		r, submitErr := s.da.Submit(ctx, []*libshare.Blob{nb.Blob}, gasPrice, opts)
+
+		retryable := false
+		if submitErr != nil {
+			if !isMempoolTimeout(submitErr) {
+				return Response{}, fmt.Errorf("submit pay-for-blob tx: %w", submitErr)
+			}
+			// SubmitPayForBlob signs a brand-new tx on every call, so
+			// blindly resubmitting after a timeout risks double-posting
+			// (and double-paying for) a blob whose original tx actually
+			// landed late. If we have a tx hash to check, confirm it
+			// didn't land before bumping gas and broadcasting again. A
+			// failed check is ambiguous, not a green light: surface the
+			// error rather than resubmit on top of an unconfirmed tx.
+			if r != nil && r.TxHash != "" {
+				landed, checkErr := s.checkTxLanded(ctx, r.TxHash)
+				if checkErr != nil {
+					return Response{}, fmt.Errorf("submit pay-for-blob tx: tx %q timed out and its landing status could not be confirmed, refusing to resubmit to avoid a double-post: %w", r.TxHash, checkErr)
+				}
+				if landed != nil {
+					result = landed
+					break
+				}
+			}
+			retryable = true
+		} else {
+			result = r
+			retryable = result.Code != 0 && isInsufficientFee(result.RawLog)
+		}
+
+		if !retryable || attempts >= maxGasBumpAttempts {
+			break
+		}
+		attempts++
+		gasPrice *= gasBumpFactor
+	}
+
+	if result != nil {
+		resp.TxHash = result.TxHash
+		resp.BlobCommitmentB64 = base64.StdEncoding.EncodeToString(nb.Commitment)
+		if result.Height > 0 {
+			resp.Height = uint64(result.Height)
+			s.keyPool.MarkUsed(keyName, resp.Height)
+			s.commitments.record(result.TxHash, result.Height, namespace, nb.Commitment)
+		}
+		resp.Code = result.Code
+		resp.RawLog = result.RawLog
+	}
+	resp.RetryAttempts = attempts
+
+	switch {
+	case result == nil:
+		return Response{}, fmt.Errorf("submit pay-for-blob tx: timed out waiting for mempool inclusion after %d gas-price bumps", attempts)
+	case result.Code != 0:
+		resp.OK = false
+		resp.Error = fmt.Sprintf("celestia tx failed with code %d", result.Code)
+	}
+
+	if bal, err := s.da.BalanceForAddress(ctx, address); err == nil {
+		resp.Balance = bal
+	}
+
+	return resp, nil
+}
+
+// checkTxLanded queries the Core GRPC tx service for txHash, so Submit can
+// tell whether a tx from an attempt that timed out waiting for mempool
+// inclusion actually landed before gas-bumping and broadcasting a new
+// signed tx in its place. It returns a nil result (with a nil error) when
+// the tx isn't found, meaning the original attempt is safe to retry.
+func (s *Service) checkTxLanded(ctx context.Context, txHash string) (*SubmitResult, error) {
+	conn, err := grpc.NewClient(s.cfg.CoreGRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("dial core grpc: %w", err)
+	}
+	defer conn.Close()
+
+	txResp, err := sdktx.NewServiceClient(conn).GetTx(ctx, &sdktx.GetTxRequest{Hash: txHash})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("look up tx %q: %w", txHash, err)
+	}
+
+	return &SubmitResult{
+		TxHash: txHash,
+		Height: txResp.TxResponse.Height,
+		Code:   txResp.TxResponse.Code,
+		RawLog: txResp.TxResponse.RawLog,
+	}, nil
+}
+
+func isInsufficientFee(rawLog string) bool {
+	return strings.Contains(strings.ToLower(rawLog), "insufficient fee")
+}
+
+func isMempoolTimeout(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "timed out waiting for tx to be included in a block")
+}
@@ -0,0 +1,184 @@
+package poster
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	nodeblob "github.com/celestiaorg/celestia-node/blob"
+	libshare "github.com/celestiaorg/go-square/v3/share"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// newTestKeyring builds a network-free in-memory keyring holding a single
+// key named keyName, the same way CreateKey provisions one against the
+// real keyring.
+func newTestKeyring(t *testing.T, keyName string) keyring.Keyring {
+	t.Helper()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	kr := keyring.NewInMemory(cdc)
+	if _, _, err := kr.NewMnemonic(keyName, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1); err != nil {
+		t.Fatalf("create test key: %v", err)
+	}
+	return kr
+}
+
+// newServiceWithSubmitter builds a Service around da directly, bypassing
+// NewService, so Submit's retry logic can be tested against a fakeSubmitter
+// without a live DA backend or Core GRPC endpoint.
+func newServiceWithSubmitter(t *testing.T, da DASubmitter) *Service {
+	t.Helper()
+	const keyName = "test-poster"
+	kr := newTestKeyring(t, keyName)
+	keyPool, err := newKeyPoolFromKeyring(kr, keyName)
+	if err != nil {
+		t.Fatalf("build key pool: %v", err)
+	}
+	return &Service{
+		cfg:         Config{DefaultKeyName: keyName},
+		kr:          kr,
+		da:          da,
+		gasOracle:   &GasPriceOracle{defaultPrice: fallbackGasPrice},
+		keyPool:     keyPool,
+		commitments: newCommitmentIndex(),
+	}
+}
+
+// fakeSubmitResponse is one canned (result, err) pair fakeSubmitter returns
+// in sequence from Submit.
+type fakeSubmitResponse struct {
+	result *SubmitResult
+	err    error
+}
+
+// fakeSubmitter is a DASubmitter that replays a fixed sequence of Submit
+// responses, repeating the last one for any calls beyond the sequence, so
+// tests can drive Submit's gas-bump retry loop deterministically.
+type fakeSubmitter struct {
+	mu        sync.Mutex
+	responses []fakeSubmitResponse
+	calls     int
+}
+
+func (f *fakeSubmitter) Submit(ctx context.Context, blobs []*libshare.Blob, gasPrice float64, opts SubmitOptions) (*SubmitResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	idx := f.calls
+	if idx >= len(f.responses) {
+		idx = len(f.responses) - 1
+	}
+	f.calls++
+	r := f.responses[idx]
+	return r.result, r.err
+}
+
+func (f *fakeSubmitter) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func (f *fakeSubmitter) Balance(ctx context.Context) (*CoinBalance, error) {
+	return &CoinBalance{Denom: "utia", Amount: "1000000"}, nil
+}
+
+func (f *fakeSubmitter) BalanceForAddress(ctx context.Context, address string) (*CoinBalance, error) {
+	return f.Balance(ctx)
+}
+
+func (f *fakeSubmitter) GetProof(ctx context.Context, height uint64, namespace libshare.Namespace, commitment []byte) (*nodeblob.Proof, error) {
+	return nil, fmt.Errorf("get_proof is not supported by fakeSubmitter")
+}
+
+func (f *fakeSubmitter) Close() error { return nil }
+
+func submitRequest(t *testing.T) Request {
+	t.Helper()
+	return Request{
+		Action:         "submit",
+		NamespaceIDB64: namespaceB64("submit"),
+		DataB64:        base64.StdEncoding.EncodeToString([]byte("hello")),
+	}
+}
+
+func TestSubmitBumpsGasOnInsufficientFeeThenSucceeds(t *testing.T) {
+	da := &fakeSubmitter{responses: []fakeSubmitResponse{
+		{result: &SubmitResult{Code: 1, RawLog: "insufficient fee: got 10utia required 20utia"}},
+		{result: &SubmitResult{TxHash: "tx-2", Height: 5, Code: 0}},
+	}}
+	svc := newServiceWithSubmitter(t, da)
+
+	resp, err := svc.Submit(context.Background(), submitRequest(t))
+	if err != nil {
+		t.Fatalf("Submit: %v", err)
+	}
+	if !resp.OK {
+		t.Fatalf("expected OK, got %+v", resp)
+	}
+	if resp.RetryAttempts != 1 {
+		t.Fatalf("expected 1 retry attempt, got %d", resp.RetryAttempts)
+	}
+	if resp.TxHash != "tx-2" || resp.Height != 5 {
+		t.Fatalf("expected the bumped submission's result, got %+v", resp)
+	}
+	if da.callCount() != 2 {
+		t.Fatalf("expected 2 Submit calls, got %d", da.callCount())
+	}
+}
+
+func TestSubmitGivesUpAfterMaxGasBumpAttemptsOnRepeatedTimeout(t *testing.T) {
+	da := &fakeSubmitter{responses: []fakeSubmitResponse{
+		// No TxHash in the result, so checkTxLanded is never reachable and
+		// this test needs no Core GRPC endpoint.
+		{result: nil, err: context.DeadlineExceeded},
+	}}
+	svc := newServiceWithSubmitter(t, da)
+
+	_, err := svc.Submit(context.Background(), submitRequest(t))
+	if err == nil {
+		t.Fatal("expected Submit to give up and return an error")
+	}
+	if want := maxGasBumpAttempts + 1; da.callCount() != want {
+		t.Fatalf("expected %d Submit calls (initial + %d retries), got %d", want, maxGasBumpAttempts, da.callCount())
+	}
+}
+
+func TestSubmitDoesNotResubmitWhenLandedCheckFails(t *testing.T) {
+	da := &fakeSubmitter{responses: []fakeSubmitResponse{
+		// TxHash is set, so Submit must confirm it didn't land before
+		// retrying. Point CoreGRPCAddr at a port with nothing listening
+		// so the lookup itself fails (distinct from a NotFound result).
+		{result: &SubmitResult{TxHash: "tx-1"}, err: context.DeadlineExceeded},
+	}}
+	svc := newServiceWithSubmitter(t, da)
+	svc.cfg.CoreGRPCAddr = "127.0.0.1:1"
+
+	_, err := svc.Submit(context.Background(), submitRequest(t))
+	if err == nil {
+		t.Fatal("expected Submit to return an error when the landed-check itself fails")
+	}
+	if da.callCount() != 1 {
+		t.Fatalf("expected Submit not to resubmit after an ambiguous landed-check, got %d calls", da.callCount())
+	}
+}
+
+func TestSubmitPropagatesNonRetryableError(t *testing.T) {
+	da := &fakeSubmitter{responses: []fakeSubmitResponse{
+		{result: nil, err: errors.New("boom")},
+	}}
+	svc := newServiceWithSubmitter(t, da)
+
+	if _, err := svc.Submit(context.Background(), submitRequest(t)); err == nil {
+		t.Fatal("expected a non-retryable submit error to be returned immediately")
+	}
+	if da.callCount() != 1 {
+		t.Fatalf("expected exactly 1 Submit call for a non-retryable error, got %d", da.callCount())
+	}
+}
@@ -0,0 +1,84 @@
+package poster
+
+// Request is a single poster action sent either over stdin (oneshot mode)
+// or as the "params" of a JSON-RPC call (serve mode).
+type Request struct {
+	Action         string      `json:"action"`
+	NamespaceIDB64 string      `json:"namespace_id_b64,omitempty"`
+	DataB64        string      `json:"data_b64,omitempty"`
+	Blobs          []BlobInput `json:"blobs,omitempty"`
+	BlobSizeBytes  int         `json:"blob_size_bytes,omitempty"`
+	GasPrice       *float64    `json:"gas_price,omitempty"`
+	KeyName        string      `json:"key_name,omitempty"`
+	SignerAddress  string      `json:"signer_address,omitempty"`
+
+	// TxHash, Height, CommitmentB64, and ProofB64 identify the inclusion a
+	// get_proof or verify_proof call operates on. get_proof accepts either
+	// TxHash or Height+CommitmentB64; verify_proof requires Height,
+	// CommitmentB64, and ProofB64. Both require NamespaceIDB64.
+	TxHash        string `json:"tx_hash,omitempty"`
+	Height        int64  `json:"height,omitempty"`
+	CommitmentB64 string `json:"commitment_b64,omitempty"`
+	ProofB64      string `json:"proof_b64,omitempty"`
+
+	// NamespaceKeyPolicy selects a signer key from the pool when KeyName is
+	// unset: "namespace_hash" picks deterministically by namespace,
+	// "round_robin" cycles through the pool. Empty means use the poster's
+	// configured default key.
+	NamespaceKeyPolicy string `json:"namespace_key_policy,omitempty"`
+	// NewKeyName names the key created by create_key or rotate_key.
+	NewKeyName string `json:"new_key_name,omitempty"`
+	// RetireKeyName names the key rotate_key should drop from the pool,
+	// defaulting to KeyName if unset.
+	RetireKeyName string `json:"retire_key_name,omitempty"`
+}
+
+// BlobInput is one element of a submit_batch request.
+type BlobInput struct {
+	NamespaceIDB64 string `json:"namespace_id_b64"`
+	DataB64        string `json:"data_b64"`
+}
+
+// CoinBalance mirrors a cosmos-sdk sdk.Coin in the subset of fields callers
+// need.
+type CoinBalance struct {
+	Denom  string `json:"denom,omitempty"`
+	Amount string `json:"amount,omitempty"`
+}
+
+// Response is the result of a single poster action. It is returned as the
+// oneshot process's stdout payload and as the JSON-RPC "result" field.
+type Response struct {
+	OK                bool         `json:"ok"`
+	Mode              string       `json:"mode,omitempty"`
+	PosterAddress     string       `json:"poster_address,omitempty"`
+	Balance           *CoinBalance `json:"balance,omitempty"`
+	TxHash            string       `json:"tx_hash,omitempty"`
+	Height            uint64       `json:"height,omitempty"`
+	Code              uint32       `json:"code,omitempty"`
+	RawLog            string       `json:"raw_log,omitempty"`
+	RetryAttempts     int          `json:"retry_attempts,omitempty"`
+	TxHashes          []string     `json:"tx_hashes,omitempty"`
+	BlobsPosted       int          `json:"blobs_posted,omitempty"`
+	BlobCommitments   []string     `json:"blob_commitments,omitempty"`
+	SharesUsed        int          `json:"shares_used,omitempty"`
+	EstimatedFee      string       `json:"estimated_fee,omitempty"`
+	KeyName           string       `json:"key_name,omitempty"`
+	PubKeyB64         string       `json:"pubkey_b64,omitempty"`
+	Keys              []KeyRecord  `json:"keys,omitempty"`
+	BlobCommitmentB64 string       `json:"blob_commitment_b64,omitempty"`
+	CommitmentB64     string       `json:"commitment_b64,omitempty"`
+	ProofB64          string       `json:"proof_b64,omitempty"`
+	RowRootsB64       []string     `json:"row_roots_b64,omitempty"`
+	Verified          *bool        `json:"verified,omitempty"`
+	Error             string       `json:"error,omitempty"`
+}
+
+// KeyRecord describes one signer key in the poster's key pool, as reported
+// by list_keys.
+type KeyRecord struct {
+	Name           string       `json:"name"`
+	Address        string       `json:"address"`
+	Balance        *CoinBalance `json:"balance,omitempty"`
+	LastUsedHeight uint64       `json:"last_used_height,omitempty"`
+}